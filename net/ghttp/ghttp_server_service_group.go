@@ -0,0 +1,35 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+// BindMultiObject registers object to the group's routes with given
+// pattern, see Server.BindMultiObject. The optional method parameter
+// behaves the same as Server.BindMultiObject's.
+//
+// It reuses RouterGroup's own prefix and middleware (g.getPrefix(),
+// g.middleware) rather than introducing a second, competing grouping
+// mechanism, so it composes with the rest of the RouterGroup API -
+// nesting, Middleware, Clone - instead of only working standalone.
+func (g *RouterGroup) BindMultiObject(pattern string, object interface{}, method ...string) {
+	bindMethod := ""
+	if len(method) > 0 {
+		bindMethod = method[0]
+	}
+	g.server.doBindMultiObject(g.getPrefix()+pattern, object, bindMethod, g.middleware, "")
+}
+
+// BindMultiObjectMethod registers specified method of object to the group's
+// routes with given pattern, see Server.BindMultiObjectMethod.
+func (g *RouterGroup) BindMultiObjectMethod(pattern string, object interface{}, method string) {
+	g.server.doBindMultiObjectMethod(g.getPrefix()+pattern, object, method, g.middleware, "")
+}
+
+// BindMultiObjectRest registers object in REST API style to the group's
+// routes with specified pattern, see Server.BindMultiObjectRest.
+func (g *RouterGroup) BindMultiObjectRest(pattern string, object interface{}) {
+	g.server.doBindMultiObjectRest(g.getPrefix()+pattern, object, g.middleware, "")
+}
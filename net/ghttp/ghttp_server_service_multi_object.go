@@ -7,9 +7,12 @@
 package ghttp
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogf/gf/container/gpool"
@@ -18,9 +21,138 @@ import (
 	"github.com/gogf/gf/text/gstr"
 )
 
-var serviceMultiObjectCache map[string]*gpool.Pool
+// defaultMultiObjectPoolTTL is used when no TTL has been configured through
+// Server.SetMultiObjectPoolTTL.
+const defaultMultiObjectPoolTTL = time.Minute * 5
 
-// var objectCache map[string][]*objectCacheInfo
+// multiObjectPoolManager owns one *gpool.Pool per registered controller struct,
+// keyed by structName, for a single Server. It replaces the old bare
+// package-level map, which was read and written by concurrent requests
+// without any synchronization. Each Server gets its own manager (see
+// Server.multiObjectPoolManager) so that SetMultiObjectPoolTTL/MaxSize on one
+// server cannot reconfigure another server's pools, even when both bind a
+// controller struct with the same name.
+type multiObjectPoolManager struct {
+	entries sync.Map // structName => *multiObjectPoolEntry
+	ttl     int64    // time.Duration, accessed via atomic so Set* can race safely with entry()
+	maxSize int64
+}
+
+// multiObjectPoolManagers holds one manager per Server, created lazily.
+var multiObjectPoolManagers sync.Map // *Server => *multiObjectPoolManager
+
+// multiObjectPoolManager returns s's pool manager, creating it on first use.
+func (s *Server) multiObjectPoolManager() *multiObjectPoolManager {
+	if v, ok := multiObjectPoolManagers.Load(s); ok {
+		return v.(*multiObjectPoolManager)
+	}
+	actual, _ := multiObjectPoolManagers.LoadOrStore(s, new(multiObjectPoolManager))
+	return actual.(*multiObjectPoolManager)
+}
+
+// multiObjectPoolEntry bundles a controller's pool together with the metrics
+// and optional size cap for that controller.
+type multiObjectPoolEntry struct {
+	pool    *gpool.Pool
+	metrics *MultiObjectPoolMetrics
+	// sem caps the number of concurrently in-use instances when maxSize > 0;
+	// it is nil, and therefore a no-op, when pooling is unbounded.
+	sem chan struct{}
+}
+
+// MultiObjectPoolMetrics exposes counters for sizing a multi-object controller
+// pool. All fields are updated atomically; while requests may be in flight,
+// read them with atomic.LoadInt64, as InUse does.
+type MultiObjectPoolMetrics struct {
+	Gets      int64
+	Puts      int64
+	Evictions int64
+}
+
+// InUse returns the number of pooled instances currently checked out.
+func (m *MultiObjectPoolMetrics) InUse() int64 {
+	return atomic.LoadInt64(&m.Gets) - atomic.LoadInt64(&m.Puts)
+}
+
+// entry returns the pool entry for structName, creating it with newFunc on
+// first use. Concurrent calls for the same structName race harmlessly on
+// creation; sync.Map.LoadOrStore guarantees only one entry ever wins.
+func (m *multiObjectPoolManager) entry(structName string, newFunc func() (interface{}, error)) *multiObjectPoolEntry {
+	if v, ok := m.entries.Load(structName); ok {
+		return v.(*multiObjectPoolEntry)
+	}
+	ttl := time.Duration(atomic.LoadInt64(&m.ttl))
+	if ttl <= 0 {
+		ttl = defaultMultiObjectPoolTTL
+	}
+	maxSize := atomic.LoadInt64(&m.maxSize)
+	e := &multiObjectPoolEntry{
+		metrics: new(MultiObjectPoolMetrics),
+	}
+	e.pool = gpool.New(ttl, newFunc, func(interface{}) {
+		atomic.AddInt64(&e.metrics.Evictions, 1)
+	})
+	if maxSize > 0 {
+		e.sem = make(chan struct{}, maxSize)
+	}
+	actual, _ := m.entries.LoadOrStore(structName, e)
+	return actual.(*multiObjectPoolEntry)
+}
+
+// Get checks an instance out of the pool, blocking if the pool has a maxSize
+// and is currently saturated.
+func (e *multiObjectPoolEntry) Get() (*serviceMultiObjectInfo, error) {
+	if e.sem != nil {
+		e.sem <- struct{}{}
+	}
+	v, err := e.pool.Get()
+	if err != nil {
+		if e.sem != nil {
+			<-e.sem
+		}
+		return nil, err
+	}
+	atomic.AddInt64(&e.metrics.Gets, 1)
+	return v.(*serviceMultiObjectInfo), nil
+}
+
+// Put returns an instance obtained from a successful Get to the pool and, when
+// the pool has a maxSize, frees that instance's slot. A Get that failed has
+// already freed its slot, so Put must not be called after it.
+func (e *multiObjectPoolEntry) Put(o *serviceMultiObjectInfo) {
+	e.pool.Put(o)
+	atomic.AddInt64(&e.metrics.Puts, 1)
+	if e.sem != nil {
+		<-e.sem
+	}
+}
+
+// SetMultiObjectPoolTTL configures how long an idle multi-object controller
+// instance stays pooled before being evicted, for controllers bound on this
+// server. It only affects pools not yet created: a controller's pool is
+// created lazily on the first request routed to it, and keeps the TTL it was
+// created with.
+func (s *Server) SetMultiObjectPoolTTL(ttl time.Duration) {
+	atomic.StoreInt64(&s.multiObjectPoolManager().ttl, int64(ttl))
+}
+
+// SetMultiObjectPoolMaxSize caps the number of concurrently in-use pooled
+// instances per controller struct bound on this server, blocking Get callers
+// beyond that cap until an instance is returned. A value <= 0 means
+// unlimited, which is also the default.
+func (s *Server) SetMultiObjectPoolMaxSize(size int) {
+	atomic.StoreInt64(&s.multiObjectPoolManager().maxSize, int64(size))
+}
+
+// MultiObjectPoolMetrics returns the pooling metrics for the controller
+// struct registered as structName on this server, or nil if no request has
+// reached such a controller yet (its pool is created on the first request).
+func (s *Server) MultiObjectPoolMetrics(structName string) *MultiObjectPoolMetrics {
+	if v, ok := s.multiObjectPoolManager().entries.Load(structName); ok {
+		return v.(*multiObjectPoolEntry).metrics
+	}
+	return nil
+}
 
 type serviceMultiObjectInfo struct {
 	rVal    reflect.Value
@@ -30,11 +162,6 @@ type serviceMultiObjectInfo struct {
 	shutFunc func(*Request)
 }
 
-func init() {
-	serviceMultiObjectCache = make(map[string]*gpool.Pool, 0)
-	// objectCache = make(map[string][]*objectCacheInfo, 0)
-}
-
 // BindObject registers object to server routes with given pattern.
 //
 // The optional parameter <method> is used to specify the method to be registered, which
@@ -65,42 +192,46 @@ func (s *Server) BindMultiObjectRest(pattern string, object interface{}) {
 	s.doBindMultiObjectRest(pattern, object, nil, "")
 }
 
-func (s *Server) callMultiObjectMethods(object interface{}, methodName string) func(*Request) {
-
+// callMultiObjectMethods returns the request handler bound to methodName on object.
+// methodIndex is the reflect.Value.Method index resolved once at bind time in
+// doBindMultiObject, so the hot path indexes directly into the method table
+// instead of re-resolving methodName through MethodByName on every request.
+func (s *Server) callMultiObjectMethods(object interface{}, methodName string, methodIndex int) func(*Request) {
 	var (
-		v = reflect.ValueOf(object)
-		t = v.Type()
+		v          = reflect.ValueOf(object)
+		t          = v.Type()
+		structName = t.Elem().Name()
 	)
 
-	structName := t.Elem().Name()
-
 	return func(r *Request) {
+		entry := s.multiObjectPoolManager().entry(structName, func() (interface{}, error) {
+			v := reflect.ValueOf(object)
+			t := v.Type()
 
-		pool, ok := serviceMultiObjectCache[structName]
-		if !ok {
-			pool = gpool.New(time.Minute*5, func() (interface{}, error) {
-				v := reflect.ValueOf(object)
-				t := v.Type()
-
-				o := new(serviceMultiObjectInfo)
-				o.rVal = reflect.New(t)
-				ov := o.rVal.Elem()
-				ov.Set(v)
-				o.methods = make(map[string]func(*Request), 0)
-
-				if ov.MethodByName("Init").IsValid() {
-					o.initFunc = ov.MethodByName("Init").Interface().(func(*Request))
-				}
-				if ov.MethodByName("Shut").IsValid() {
-					o.shutFunc = ov.MethodByName("Shut").Interface().(func(*Request))
-				}
-				return o, nil
-			})
-			serviceMultiObjectCache[structName] = pool
-		}
+			o := new(serviceMultiObjectInfo)
+			o.rVal = reflect.New(t)
+			ov := o.rVal.Elem()
+			ov.Set(v)
+			o.methods = make(map[string]func(*Request), 0)
 
-		po, _ := pool.Get()
-		o := po.(*serviceMultiObjectInfo)
+			if ov.MethodByName("Init").IsValid() {
+				o.initFunc = ov.MethodByName("Init").Interface().(func(*Request))
+			}
+			if ov.MethodByName("Shut").IsValid() {
+				o.shutFunc = ov.MethodByName("Shut").Interface().(func(*Request))
+			}
+			return o, nil
+		})
+
+		o, err := entry.Get()
+		if err != nil {
+			s.Logger().Error(err)
+			return
+		}
+		// Always return the instance to the pool, even if a user handler
+		// panics; niceCallFunc below recovers the panic, but if it didn't,
+		// this still ensures the instance is not leaked out of the pool.
+		defer entry.Put(o)
 
 		if o.initFunc != nil {
 			niceCallFunc(func() {
@@ -108,17 +239,15 @@ func (s *Server) callMultiObjectMethods(object interface{}, methodName string) f
 			})
 		}
 
-		if itemFunc, ok := o.methods[methodName]; !ok {
-			v := o.rVal
-			ov := v.Elem()
-			methodValue := ov.MethodByName(methodName)
-			if itemFunc, ok := methodValue.Interface().(func(*Request)); ok {
+		itemFunc, ok := o.methods[methodName]
+		if !ok {
+			methodValue := o.rVal.Elem().Method(methodIndex)
+			itemFunc, ok = methodValue.Interface().(func(*Request))
+			if ok {
 				o.methods[methodName] = itemFunc
-				niceCallFunc(func() {
-					itemFunc(r)
-				})
 			}
-		} else {
+		}
+		if ok {
 			niceCallFunc(func() {
 				itemFunc(r)
 			})
@@ -129,9 +258,140 @@ func (s *Server) callMultiObjectMethods(object interface{}, methodName string) f
 				o.shutFunc(r)
 			})
 		}
+	}
+}
+
+// multiObjectHandlerKind distinguishes the first-parameter shape of a typed
+// multi-object handler, so callTypedMultiObjectMethod knows what to pass it.
+type multiObjectHandlerKind int
+
+const (
+	multiObjectHandlerKindRequest multiObjectHandlerKind = iota + 1
+	multiObjectHandlerKindContext
+)
+
+// detectTypedMultiObjectHandler reports whether m is shaped like
+// func(*Request, *In) (*Out, error) or func(context.Context, *In) (*Out, error),
+// returning which kind matched along with the In/Out element types. Legacy
+// func(*Request) methods do not match this and keep being handled by the
+// plain reflect type assertion already in doBindMultiObject.
+func detectTypedMultiObjectHandler(m reflect.Value) (kind multiObjectHandlerKind, reqType, respType reflect.Type, ok bool) {
+	t := m.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 2 {
+		return 0, nil, nil, false
+	}
+	if t.In(1).Kind() != reflect.Ptr || t.In(1).Elem().Kind() != reflect.Struct {
+		return 0, nil, nil, false
+	}
+	if t.Out(0).Kind() != reflect.Ptr || t.Out(0).Elem().Kind() != reflect.Struct {
+		return 0, nil, nil, false
+	}
+	if !t.Out(1).Implements(reflect.TypeOf((*error)(nil)).Elem()) {
+		return 0, nil, nil, false
+	}
+	switch t.In(0) {
+	case reflect.TypeOf((*Request)(nil)):
+		return multiObjectHandlerKindRequest, t.In(1).Elem(), t.Out(0).Elem(), true
+	case reflect.TypeOf((*context.Context)(nil)).Elem():
+		return multiObjectHandlerKindContext, t.In(1).Elem(), t.Out(0).Elem(), true
+	}
+	return 0, nil, nil, false
+}
+
+// callTypedMultiObjectMethod returns the request handler that adapts a typed
+// controller method (see detectTypedMultiObjectHandler) to ghttp's plain
+// func(*Request) handler shape: allocate and bind *reqType off the request,
+// validate it, call the method, then marshal its *Out result back.
+func (s *Server) callTypedMultiObjectMethod(
+	object interface{}, methodIndex int, kind multiObjectHandlerKind, reqType reflect.Type,
+) func(*Request) {
+	var (
+		v          = reflect.ValueOf(object)
+		t          = v.Type()
+		structName = t.Elem().Name()
+	)
+
+	return func(r *Request) {
+		entry := s.multiObjectPoolManager().entry(structName, func() (interface{}, error) {
+			v := reflect.ValueOf(object)
+			t := v.Type()
+
+			o := new(serviceMultiObjectInfo)
+			o.rVal = reflect.New(t)
+			ov := o.rVal.Elem()
+			ov.Set(v)
+			o.methods = make(map[string]func(*Request), 0)
+
+			if ov.MethodByName("Init").IsValid() {
+				o.initFunc = ov.MethodByName("Init").Interface().(func(*Request))
+			}
+			if ov.MethodByName("Shut").IsValid() {
+				o.shutFunc = ov.MethodByName("Shut").Interface().(func(*Request))
+			}
+			return o, nil
+		})
+
+		o, err := entry.Get()
+		if err != nil {
+			s.Logger().Error(err)
+			return
+		}
+		defer entry.Put(o)
+
+		if o.initFunc != nil {
+			niceCallFunc(func() {
+				o.initFunc(r)
+			})
+		}
+
+		niceCallFunc(func() {
+			s.callTypedHandler(r, o.rVal.Elem().Method(methodIndex), kind, reqType)
+		})
+
+		if o.shutFunc != nil {
+			niceCallFunc(func() {
+				o.shutFunc(r)
+			})
+		}
+	}
+}
 
-		pool.Put(o)
+// callTypedHandler binds and validates the request body into a new *reqType
+// (reusing Request.Parse's binding/gvalid semantics), invokes methodValue
+// with it, and marshals its *Out result according to the request's Accept
+// header.
+func (s *Server) callTypedHandler(r *Request, methodValue reflect.Value, kind multiObjectHandlerKind, reqType reflect.Type) {
+	reqPtr := reflect.New(reqType)
+	if err := r.Parse(reqPtr.Interface()); err != nil {
+		r.Response.WriteJson(map[string]interface{}{
+			"code":    1,
+			"message": err.Error(),
+		})
+		return
 	}
+
+	var in reflect.Value
+	if kind == multiObjectHandlerKindContext {
+		in = reflect.ValueOf(r.Context())
+	} else {
+		in = reflect.ValueOf(r)
+	}
+
+	results := methodValue.Call([]reflect.Value{in, reqPtr})
+	if errVal := results[1]; !errVal.IsNil() {
+		r.Response.WriteJson(map[string]interface{}{
+			"code":    1,
+			"message": errVal.Interface().(error).Error(),
+		})
+		return
+	}
+
+	resp := results[0].Interface()
+	if strings.Contains(r.Request.Header.Get("Accept"), "xml") {
+		r.Response.WriteXml(resp)
+		return
+	}
+	r.Response.WriteJson(resp)
 }
 
 func (s *Server) doBindMultiObject(
@@ -160,8 +420,6 @@ func (s *Server) doBindMultiObject(
 		m = make(map[string]*handlerItem)
 		v = reflect.ValueOf(object)
 		t = v.Type()
-		// initFunc func(*Request)
-		// shutFunc func(*Request)
 	)
 	// If given `object` is not pointer, it then creates a temporary one,
 	// of which the value is `v`.
@@ -172,12 +430,6 @@ func (s *Server) doBindMultiObject(
 		t = v.Type()
 	}
 	structName := t.Elem().Name()
-	// if v.MethodByName("Init").IsValid() {
-	// 	initFunc = v.MethodByName("Init").Interface().(func(*Request))
-	// }
-	// if v.MethodByName("Shut").IsValid() {
-	// 	shutFunc = v.MethodByName("Shut").Interface().(func(*Request))
-	// }
 	pkgPath := t.Elem().PkgPath()
 	pkgName := gfile.Basename(pkgPath)
 	for i := 0; i < v.NumMethod(); i++ {
@@ -194,6 +446,32 @@ func (s *Server) doBindMultiObject(
 		}
 		_, ok := v.Method(i).Interface().(func(*Request))
 		if !ok {
+			if kind, reqType, respType, isTyped := detectTypedMultiObjectHandler(v.Method(i)); isTyped {
+				key := s.mergeBuildInNameToPattern(pattern, structName, methodName, true)
+				recordOpenAPIRoute(s, &openAPIRouteInfo{
+					Method: method,
+					// Path, unlike key above, must not carry gf's own
+					// "<verb>:" route-key prefix (pattern still has one
+					// whenever the caller hardcoded a verb, since parsePattern
+					// only strips it for the defaultMethod case above) - it is
+					// the plain URL path that belongs in the generated spec's
+					// `paths`, so it is built from path instead of pattern.
+					Path:       s.mergeBuildInNameToPattern(path, structName, methodName, true),
+					PkgPath:    pkgPath,
+					StructName: structName,
+					MethodName: methodName,
+					ReqType:    reqType,
+					RespType:   respType,
+				})
+				m[key] = &handlerItem{
+					itemName:   fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
+					itemType:   handlerTypeHandler,
+					itemFunc:   s.callTypedMultiObjectMethod(object, i, kind, reqType),
+					middleware: middleware,
+					source:     source,
+				}
+				continue
+			}
 			if len(methodMap) > 0 {
 				s.Logger().Errorf(
 					`invalid route method: %s.%s.%s defined as "%s", but "func(*ghttp.Request)" is required for object registry`,
@@ -208,13 +486,17 @@ func (s *Server) doBindMultiObject(
 			continue
 		}
 		key := s.mergeBuildInNameToPattern(pattern, structName, methodName, true)
+		recordOpenAPIRoute(s, &openAPIRouteInfo{
+			Method:     method,
+			Path:       s.mergeBuildInNameToPattern(path, structName, methodName, true),
+			PkgPath:    pkgPath,
+			StructName: structName,
+			MethodName: methodName,
+		})
 		m[key] = &handlerItem{
-			itemName: fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
-			itemType: handlerTypeHandler, //  handlerTypeObject,
-			itemFunc: s.callMultiObjectMethods(object, methodName),
-			// itemFunc: itemFunc,
-			// initFunc:   initFunc,
-			// shutFunc:   shutFunc,
+			itemName:   fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
+			itemType:   handlerTypeHandler,
+			itemFunc:   s.callMultiObjectMethods(object, methodName, i),
 			middleware: middleware,
 			source:     source,
 		}
@@ -232,11 +514,9 @@ func (s *Server) doBindMultiObject(
 				k = "/" + k
 			}
 			m[k] = &handlerItem{
-				itemName: fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
-				itemType: handlerTypeHandler, //   handlerTypeObject,
-				itemFunc: s.callMultiObjectMethods(object, methodName),
-				// initFunc:   initFunc,
-				// shutFunc:   shutFunc,
+				itemName:   fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
+				itemType:   handlerTypeHandler,
+				itemFunc:   s.callMultiObjectMethods(object, methodName, i),
 				middleware: middleware,
 				source:     source,
 			}
@@ -253,8 +533,6 @@ func (s *Server) doBindMultiObjectMethod(
 		m = make(map[string]*handlerItem)
 		v = reflect.ValueOf(object)
 		t = v.Type()
-		// initFunc func(*Request)
-		// shutFunc func(*Request)
 	)
 	// If given `object` is not pointer, it then creates a temporary one,
 	// of which the value is `v`.
@@ -271,12 +549,6 @@ func (s *Server) doBindMultiObjectMethod(
 		s.Logger().Fatal("invalid method name: " + methodName)
 		return
 	}
-	// if v.MethodByName("Init").IsValid() {
-	// 	initFunc = v.MethodByName("Init").Interface().(func(*Request))
-	// }
-	// if v.MethodByName("Shut").IsValid() {
-	// 	shutFunc = v.MethodByName("Shut").Interface().(func(*Request))
-	// }
 	pkgPath := t.Elem().PkgPath()
 	pkgName := gfile.Basename(pkgPath)
 	objName := gstr.Replace(t.String(), fmt.Sprintf(`%s.`, pkgName), "")
@@ -291,15 +563,18 @@ func (s *Server) doBindMultiObjectMethod(
 		)
 		return
 	}
+	methodIndex := -1
+	for i := 0; i < v.NumMethod(); i++ {
+		if t.Method(i).Name == methodName {
+			methodIndex = i
+			break
+		}
+	}
 	key := s.mergeBuildInNameToPattern(pattern, structName, methodName, false)
 	m[key] = &handlerItem{
-		itemName: fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
-		itemType: handlerTypeHandler,
-		itemFunc: s.callMultiObjectMethods(object, methodName),
-		// itemType:   handlerTypeObject,
-		// itemFunc:   itemFunc,
-		// initFunc:   initFunc,
-		// shutFunc:   shutFunc,
+		itemName:   fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
+		itemType:   handlerTypeHandler,
+		itemFunc:   s.callMultiObjectMethods(object, methodName, methodIndex),
 		middleware: middleware,
 		source:     source,
 	}
@@ -315,8 +590,6 @@ func (s *Server) doBindMultiObjectRest(
 		m = make(map[string]*handlerItem)
 		v = reflect.ValueOf(object)
 		t = v.Type()
-		// initFunc func(*Request)
-		// shutFunc func(*Request)
 	)
 	// If given `object` is not pointer, it then creates a temporary one,
 	// of which the value is `v`.
@@ -327,12 +600,6 @@ func (s *Server) doBindMultiObjectRest(
 		t = v.Type()
 	}
 	structName := t.Elem().Name()
-	// if v.MethodByName("Init").IsValid() {
-	// 	initFunc = v.MethodByName("Init").Interface().(func(*Request))
-	// }
-	// if v.MethodByName("Shut").IsValid() {
-	// 	shutFunc = v.MethodByName("Shut").Interface().(func(*Request))
-	// }
 	pkgPath := t.Elem().PkgPath()
 	for i := 0; i < v.NumMethod(); i++ {
 		methodName := t.Method(i).Name
@@ -346,6 +613,31 @@ func (s *Server) doBindMultiObjectRest(
 		}
 		_, ok := v.Method(i).Interface().(func(*Request))
 		if !ok {
+			if kind, reqType, respType, isTyped := detectTypedMultiObjectHandler(v.Method(i)); isTyped {
+				key := s.mergeBuildInNameToPattern(methodName+":"+pattern, structName, methodName, false)
+				recordOpenAPIRoute(s, &openAPIRouteInfo{
+					Method: methodName,
+					// Path is the URL path built from pattern alone, unlike
+					// key (above), which is the internal
+					// route-registration key and carries gf's own
+					// "<verb>:" prefix (e.g. "Get:/user/{id}"), which would
+					// leak into the generated spec's `paths` if used as-is.
+					Path:       s.mergeBuildInNameToPattern(pattern, structName, methodName, false),
+					PkgPath:    pkgPath,
+					StructName: structName,
+					MethodName: methodName,
+					ReqType:    reqType,
+					RespType:   respType,
+				})
+				m[key] = &handlerItem{
+					itemName:   fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
+					itemType:   handlerTypeHandler,
+					itemFunc:   s.callTypedMultiObjectMethod(object, i, kind, reqType),
+					middleware: middleware,
+					source:     source,
+				}
+				continue
+			}
 			s.Logger().Errorf(
 				`invalid route method: %s.%s.%s defined as "%s", but "func(*ghttp.Request)" is required for object registry`,
 				pkgPath, objName, methodName, v.Method(i).Type().String(),
@@ -353,15 +645,17 @@ func (s *Server) doBindMultiObjectRest(
 			continue
 		}
 		key := s.mergeBuildInNameToPattern(methodName+":"+pattern, structName, methodName, false)
+		recordOpenAPIRoute(s, &openAPIRouteInfo{
+			Method:     methodName,
+			Path:       s.mergeBuildInNameToPattern(pattern, structName, methodName, false),
+			PkgPath:    pkgPath,
+			StructName: structName,
+			MethodName: methodName,
+		})
 		m[key] = &handlerItem{
-			itemName: fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
-			itemType: handlerTypeHandler,
-			itemFunc: s.callMultiObjectMethods(object, methodName),
-
-			// itemType:   handlerTypeObject,
-			// itemFunc:   itemFunc,
-			// initFunc:   initFunc,
-			// shutFunc:   shutFunc,
+			itemName:   fmt.Sprintf(`%s.%s.%s`, pkgPath, objName, methodName),
+			itemType:   handlerTypeHandler,
+			itemFunc:   s.callMultiObjectMethods(object, methodName, i),
 			middleware: middleware,
 			source:     source,
 		}
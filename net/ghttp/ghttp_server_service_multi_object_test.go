@@ -0,0 +1,84 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestMultiObjectPoolManagerRace exercises the pool manager under concurrent
+// Get/Put and SetMultiObjectPoolTTL/MaxSize calls; run with -race, it catches
+// the kind of unsynchronized read/write on a shared map that the original
+// serviceMultiObjectCache had.
+func TestMultiObjectPoolManagerRace(t *testing.T) {
+	s := &Server{}
+	newFunc := func() (interface{}, error) {
+		return &serviceMultiObjectInfo{
+			methods: make(map[string]func(*Request)),
+		}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%10 == 0 {
+				s.SetMultiObjectPoolTTL(time.Minute)
+				s.SetMultiObjectPoolMaxSize(5)
+			}
+			entry := s.multiObjectPoolManager().entry("Ctrl", newFunc)
+			o, err := entry.Get()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			entry.Put(o)
+		}(i)
+	}
+	wg.Wait()
+
+	m := s.MultiObjectPoolMetrics("Ctrl")
+	if m == nil || m.Gets == 0 {
+		t.Fatalf("expected pool metrics to be recorded, got %+v", m)
+	}
+}
+
+// TestMultiObjectPoolManagerIsolatedPerServer guards against the pool
+// manager regressing into a single process-wide singleton: two servers
+// binding a same-named controller must not share TTL, max size or metrics.
+func TestMultiObjectPoolManagerIsolatedPerServer(t *testing.T) {
+	a, b := &Server{}, &Server{}
+	a.SetMultiObjectPoolTTL(time.Second)
+	b.SetMultiObjectPoolTTL(time.Minute)
+
+	if got := time.Duration(a.multiObjectPoolManager().ttl); got != time.Second {
+		t.Fatalf("server a ttl = %v, want %v", got, time.Second)
+	}
+	if got := time.Duration(b.multiObjectPoolManager().ttl); got != time.Minute {
+		t.Fatalf("server b ttl = %v, want %v", got, time.Minute)
+	}
+
+	newFunc := func() (interface{}, error) {
+		return &serviceMultiObjectInfo{methods: make(map[string]func(*Request))}, nil
+	}
+	entry := a.multiObjectPoolManager().entry("SharedName", newFunc)
+	o, err := entry.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry.Put(o)
+
+	if a.MultiObjectPoolMetrics("SharedName") == nil {
+		t.Fatal("expected server a to have metrics for SharedName")
+	}
+	if b.MultiObjectPoolMetrics("SharedName") != nil {
+		t.Fatal("server b should not see server a's pool metrics for the same struct name")
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// openAPIRouteInfo is recorded once per bound multi-object route, at the
+// same point doBindMultiObject/doBindMultiObjectRest build the handlerItem
+// for it, so that Server.OpenAPI can describe the route without re-walking
+// the controller with reflection.
+type openAPIRouteInfo struct {
+	Method     string
+	Path       string
+	PkgPath    string
+	StructName string
+	MethodName string
+	ReqType    reflect.Type
+	RespType   reflect.Type
+}
+
+// openAPIRouteRegistry owns the routes recorded for a single Server, behind
+// its own mutex, so recording on one server never waits on another's.
+// Registries are kept in a package-level map keyed by *Server and are never
+// removed from it.
+type openAPIRouteRegistry struct {
+	mu     sync.Mutex
+	routes []*openAPIRouteInfo
+}
+
+// openAPIRouteRegistries holds one registry per Server, created lazily.
+var openAPIRouteRegistries sync.Map // *Server => *openAPIRouteRegistry
+
+// openAPIRouteRegistry returns s's route registry, creating it on first use.
+func (s *Server) openAPIRouteRegistry() *openAPIRouteRegistry {
+	if v, ok := openAPIRouteRegistries.Load(s); ok {
+		return v.(*openAPIRouteRegistry)
+	}
+	actual, _ := openAPIRouteRegistries.LoadOrStore(s, new(openAPIRouteRegistry))
+	return actual.(*openAPIRouteRegistry)
+}
+
+// recordOpenAPIRoute appends info to the routes known to have been bound on s.
+func recordOpenAPIRoute(s *Server, info *openAPIRouteInfo) {
+	registry := s.openAPIRouteRegistry()
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.routes = append(registry.routes, info)
+}
+
+// OpenAPI builds an OpenAPI 3.0 document describing every multi-object route
+// bound on the server so far via BindMultiObject or BindMultiObjectRest.
+// Routes bound with BindMultiObjectMethod or BindOpenAPI are not recorded and
+// do not appear in the document.
+func (s *Server) OpenAPI() *openapi3.T {
+	registry := s.openAPIRouteRegistry()
+	registry.mu.Lock()
+	routes := append([]*openAPIRouteInfo{}, registry.routes...)
+	registry.mu.Unlock()
+
+	doc := &openapi3.T{
+		OpenAPI: "3.0.0",
+		Info: &openapi3.Info{
+			Title:   s.GetName(),
+			Version: "1.0.0",
+		},
+		Paths:      make(openapi3.Paths),
+		Components: openapi3.Components{Schemas: make(openapi3.Schemas)},
+	}
+	for _, route := range routes {
+		pathItem := doc.Paths[route.Path]
+		if pathItem == nil {
+			pathItem = &openapi3.PathItem{}
+			doc.Paths[route.Path] = pathItem
+		}
+		op := &openapi3.Operation{
+			OperationID: route.StructName + "." + route.MethodName,
+			Tags:        []string{route.StructName},
+			Responses:   make(openapi3.Responses),
+		}
+		if route.ReqType != nil {
+			op.RequestBody = &openapi3.RequestBodyRef{
+				Value: openapi3.NewRequestBody().WithJSONSchemaRef(s.openAPISchemaRef(doc, route.ReqType)),
+			}
+		}
+		if route.RespType != nil {
+			op.Responses["200"] = &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().
+					WithDescription("OK").
+					WithJSONSchemaRef(s.openAPISchemaRef(doc, route.RespType)),
+			}
+		} else {
+			op.Responses["200"] = &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().WithDescription("OK"),
+			}
+		}
+		setOpenAPIOperation(pathItem, route.Method, op)
+	}
+	return doc
+}
+
+// setOpenAPIOperation registers op on pathItem for method. openapi3.PathItem.
+// SetOperation only accepts exact http.MethodXxx-style all-caps verbs and
+// panics on anything else, but route.Method can be gf's own "ALL" sentinel
+// (any BindMultiObject* pattern that doesn't hardcode a single verb) or a
+// mixed-case Go method name such as "Get" (BindMultiObjectRest). Normalize
+// both: "ALL" expands into one operation per concrete HTTP method, anything
+// else is upper-cased first.
+func setOpenAPIOperation(pathItem *openapi3.PathItem, method string, op *openapi3.Operation) {
+	verb := strings.ToUpper(method)
+	if verb == "" || verb == strings.ToUpper(defaultMethod) {
+		for m := range methodsMap {
+			pathItem.SetOperation(m, op)
+		}
+		return
+	}
+	pathItem.SetOperation(verb, op)
+}
+
+// openAPISchemaName derives the components.schemas key for t, disambiguating
+// same-named structs declared in different packages.
+func openAPISchemaName(t reflect.Type) string {
+	return strings.ReplaceAll(t.PkgPath(), "/", "_") + "." + t.Name()
+}
+
+// openAPISchemaRef returns a $ref to t's schema in doc.Components.Schemas,
+// registering it first if this is the first time t is seen, so that a type
+// referenced by several operations is only defined once in the document.
+func (s *Server) openAPISchemaRef(doc *openapi3.T, t reflect.Type) *openapi3.SchemaRef {
+	// Keyed by package path + name, not just the bare type name: two
+	// controllers in different packages commonly both declare a "Resp"
+	// struct, and a bare-name key would make the second one silently
+	// overwrite the first's entry in components.schemas.
+	name := openAPISchemaName(t)
+	if existing, ok := doc.Components.Schemas[name]; ok {
+		return openapi3.NewSchemaRef("#/components/schemas/"+name, existing.Value)
+	}
+	schema := openapi3.NewObjectSchema()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldName := field.Name
+		switch {
+		case field.Tag.Get("json") != "":
+			fieldName = strings.Split(field.Tag.Get("json"), ",")[0]
+		case field.Tag.Get("form") != "":
+			fieldName = strings.Split(field.Tag.Get("form"), ",")[0]
+		case field.Tag.Get("p") != "":
+			fieldName = field.Tag.Get("p")
+		}
+		fieldSchema := s.openAPIFieldSchema(doc, field.Type)
+		if desc := field.Tag.Get("description"); desc != "" {
+			fieldSchema.Description = desc
+		}
+		schema.Properties[fieldName] = openapi3.NewSchemaRef("", fieldSchema)
+	}
+	doc.Components.Schemas[name] = openapi3.NewSchemaRef("", schema)
+	return openapi3.NewSchemaRef("#/components/schemas/"+name, schema)
+}
+
+// openAPIFieldSchema maps a Go field type to its OpenAPI schema, recursing
+// into components.schemas for nested struct types.
+func (s *Server) openAPIFieldSchema(doc *openapi3.T, t reflect.Type) *openapi3.Schema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return s.openAPIFieldSchema(doc, t.Elem())
+	case reflect.String:
+		return openapi3.NewStringSchema()
+	case reflect.Bool:
+		return openapi3.NewBoolSchema()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return openapi3.NewIntegerSchema()
+	case reflect.Float32, reflect.Float64:
+		return openapi3.NewFloat64Schema()
+	case reflect.Slice, reflect.Array:
+		return openapi3.NewArraySchema().WithItems(s.openAPIFieldSchema(doc, t.Elem()))
+	case reflect.Struct:
+		return s.openAPISchemaRef(doc, t).Value
+	default:
+		return openapi3.NewStringSchema()
+	}
+}
+
+// EnableOpenAPI registers a GET /openapi.json route serving the document
+// built by Server.OpenAPI. When ui is true, it also serves a Swagger-UI page
+// at GET /swagger pointed at that document.
+func (s *Server) EnableOpenAPI(ui bool) {
+	s.BindHandler("GET:/openapi.json", func(r *Request) {
+		r.Response.WriteJson(s.OpenAPI())
+	})
+	if ui {
+		s.BindHandler("GET:/swagger", func(r *Request) {
+			r.Response.Header().Set("Content-Type", "text/html; charset=utf-8")
+			r.Response.Write(swaggerUIHTML)
+		})
+	}
+}
+
+const swaggerUIHTML = `<!DOCTYPE html>
+<html>
+<head>
+	<title>API Docs</title>
+	<link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist/swagger-ui.css"/>
+</head>
+<body>
+	<div id="swagger-ui"></div>
+	<script src="https://unpkg.com/swagger-ui-dist/swagger-ui-bundle.js"></script>
+	<script>
+		window.onload = function() {
+			SwaggerUIBundle({ url: '/openapi.json', dom_id: '#swagger-ui' });
+		};
+	</script>
+</body>
+</html>
+`
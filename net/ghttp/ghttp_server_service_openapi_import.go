@@ -0,0 +1,129 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/gogf/gf/encoding/gjson"
+)
+
+// BindOpenAPI parses an OpenAPI 2.0 or 3.0 document at specPath and binds its
+// operations onto objects, keyed there by the spec's `tag` (the controller
+// name). For each operation the method named by its `operationId` is looked
+// up on the matched object and wired through the same dispatch used by
+// BindMultiObject, so both legacy `func(*Request)` and typed handlers are
+// supported.
+//
+// Operations whose tag or operationId cannot be matched to a provided
+// object/method are logged as warnings and skipped rather than treated as
+// fatal, so a spec can be imported incrementally as controllers are added.
+func (s *Server) BindOpenAPI(specPath string, objects map[string]interface{}) error {
+	doc, err := loadOpenAPIDocument(specPath)
+	if err != nil {
+		return err
+	}
+	for path, pathItem := range doc.Paths {
+		for method, op := range pathItem.Operations() {
+			if op == nil || op.OperationID == "" {
+				continue
+			}
+			if len(op.Tags) == 0 {
+				s.Logger().Warningf(`openapi import: operation "%s" has no tag, skipped`, op.OperationID)
+				continue
+			}
+			tag := op.Tags[0]
+			object, ok := objects[tag]
+			if !ok {
+				s.Logger().Warningf(`openapi import: no object provided for tag "%s", operation "%s" skipped`, tag, op.OperationID)
+				continue
+			}
+			if err := s.bindOpenAPIOperation(method, path, object, op.OperationID); err != nil {
+				s.Logger().Warningf(`openapi import: %s`, err)
+			}
+		}
+	}
+	return nil
+}
+
+// loadOpenAPIDocument loads specPath as an OpenAPI 3.0 document, upconverting
+// it from Swagger 2.0 first if that's what it turns out to be.
+func loadOpenAPIDocument(specPath string) (*openapi3.T, error) {
+	if doc, err := openapi3.NewLoader().LoadFromFile(specPath); err == nil && doc.OpenAPI != "" {
+		return doc, nil
+	}
+	j, err := gjson.Load(specPath)
+	if err != nil {
+		return nil, err
+	}
+	var doc2 openapi2.T
+	if err := j.Scan(&doc2); err != nil {
+		return nil, fmt.Errorf(`specPath "%s" is neither a valid OpenAPI 3.0 nor Swagger 2.0 document: %v`, specPath, err)
+	}
+	return openapi2conv.ToV3(&doc2)
+}
+
+// openAPIPathParam matches a `{name}` path-parameter segment, the syntax
+// OpenAPI specs use for path parameters (e.g. "/users/{id}").
+var openAPIPathParam = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// openAPIPathToPattern translates an OpenAPI path's `{name}` placeholders
+// into gf's own `:name` route pattern syntax. Binding a spec path as-is
+// would register a dead literal route ("/users/{id}" never matches a real
+// request), since gf's router only recognizes ":name"/"*name" placeholders.
+func openAPIPathToPattern(path string) string {
+	return openAPIPathParam.ReplaceAllString(path, ":$1")
+}
+
+// bindOpenAPIOperation resolves operationID to a method on object and binds
+// it as a route for method+path, using the typed or legacy dispatch that
+// matches the method's signature.
+func (s *Server) bindOpenAPIOperation(method, path string, object interface{}, operationID string) error {
+	v := reflect.ValueOf(object)
+	if v.Kind() != reflect.Ptr {
+		newValue := reflect.New(v.Type())
+		newValue.Elem().Set(v)
+		v = newValue
+		object = v.Interface()
+	}
+	structName := v.Type().Elem().Name()
+	methodValue := v.MethodByName(operationID)
+	if !methodValue.IsValid() {
+		return fmt.Errorf(`operationId "%s" has no matching method on %s`, operationID, structName)
+	}
+	methodIndex := -1
+	for i := 0; i < v.NumMethod(); i++ {
+		if v.Type().Method(i).Name == operationID {
+			methodIndex = i
+			break
+		}
+	}
+	key := strings.ToUpper(method) + ":" + openAPIPathToPattern(path)
+	var itemFunc func(*Request)
+	if _, ok := methodValue.Interface().(func(*Request)); ok {
+		itemFunc = s.callMultiObjectMethods(object, operationID, methodIndex)
+	} else if kind, reqType, _, ok := detectTypedMultiObjectHandler(methodValue); ok {
+		itemFunc = s.callTypedMultiObjectMethod(object, methodIndex, kind, reqType)
+	} else {
+		return fmt.Errorf(`method %s.%s has unsupported signature "%s" for binding`, structName, operationID, methodValue.Type().String())
+	}
+	s.bindHandlerByMap(map[string]*handlerItem{
+		key: {
+			itemName: fmt.Sprintf(`%s.%s`, structName, operationID),
+			itemType: handlerTypeHandler,
+			itemFunc: itemFunc,
+		},
+	})
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import "testing"
+
+// TestOpenAPIPathToPattern is a regression test for BindOpenAPI registering
+// dead literal routes: OpenAPI path parameters use "{name}" while gf's
+// router only recognizes ":name"/"*name".
+func TestOpenAPIPathToPattern(t *testing.T) {
+	cases := []struct {
+		path string
+		want string
+	}{
+		{"/users", "/users"},
+		{"/users/{id}", "/users/:id"},
+		{"/users/{userId}/posts/{postId}", "/users/:userId/posts/:postId"},
+	}
+	for _, c := range cases {
+		if got := openAPIPathToPattern(c.path); got != c.want {
+			t.Errorf("openAPIPathToPattern(%q) = %q, want %q", c.path, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright GoFrame Author(https://goframe.org). All Rights Reserved.
+//
+// This Source Code Form is subject to the terms of the MIT License.
+// If a copy of the MIT was not distributed with this file,
+// You can obtain one at https://github.com/gogf/gf.
+
+package ghttp
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type openAPITestReq struct {
+	Name string `json:"name"`
+}
+
+type openAPITestResp struct {
+	Greeting string `json:"greeting"`
+}
+
+type openAPITestCtrl struct{}
+
+func (openAPITestCtrl) Legacy(r *Request) {}
+func (openAPITestCtrl) Typed(r *Request, in *openAPITestReq) (*openAPITestResp, error) {
+	return nil, nil
+}
+func (openAPITestCtrl) TypedCtx(ctx context.Context, in *openAPITestReq) (*openAPITestResp, error) {
+	return nil, nil
+}
+func (openAPITestCtrl) Bad(in openAPITestReq) string { return "" }
+
+// TestDetectTypedMultiObjectHandler covers the signature matching that both
+// the typed-dispatch adapter (callTypedMultiObjectMethod) and the OpenAPI
+// schema recorder rely on.
+func TestDetectTypedMultiObjectHandler(t *testing.T) {
+	v := reflect.ValueOf(openAPITestCtrl{})
+	cases := []struct {
+		name     string
+		method   string
+		wantOK   bool
+		wantKind multiObjectHandlerKind
+	}{
+		{"legacy func(*Request) does not match", "Legacy", false, 0},
+		{"typed func(*Request, *In) (*Out, error)", "Typed", true, multiObjectHandlerKindRequest},
+		{"typed func(context.Context, *In) (*Out, error)", "TypedCtx", true, multiObjectHandlerKindContext},
+		{"unsupported signature", "Bad", false, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			kind, reqType, respType, ok := detectTypedMultiObjectHandler(v.MethodByName(c.method))
+			if ok != c.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, c.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if kind != c.wantKind {
+				t.Fatalf("kind = %v, want %v", kind, c.wantKind)
+			}
+			if reqType != reflect.TypeOf(openAPITestReq{}) || respType != reflect.TypeOf(openAPITestResp{}) {
+				t.Fatalf("reqType/respType = %v/%v, want %v/%v",
+					reqType, respType, reflect.TypeOf(openAPITestReq{}), reflect.TypeOf(openAPITestResp{}))
+			}
+		})
+	}
+}
+
+// TestSetOpenAPIOperation is a regression test for the panic reported on
+// Server.OpenAPI(): gf's "ALL" verb sentinel and REST's mixed-case Go method
+// names both used to be passed straight into openapi3.PathItem.SetOperation,
+// which panics on anything but an exact http.MethodXxx value.
+func TestSetOpenAPIOperation(t *testing.T) {
+	t.Run("ALL expands into one operation per concrete HTTP method", func(t *testing.T) {
+		pathItem := &openapi3.PathItem{}
+		op := &openapi3.Operation{OperationID: "x"}
+		setOpenAPIOperation(pathItem, defaultMethod, op)
+		for verb := range methodsMap {
+			if pathItem.GetOperation(verb) != op {
+				t.Fatalf("expected operation registered for verb %s", verb)
+			}
+		}
+	})
+
+	t.Run("mixed-case REST method name is upper-cased", func(t *testing.T) {
+		pathItem := &openapi3.PathItem{}
+		op := &openapi3.Operation{OperationID: "y"}
+		setOpenAPIOperation(pathItem, "Get", op)
+		if pathItem.GetOperation(http.MethodGet) != op {
+			t.Fatalf("expected %s operation to be set", http.MethodGet)
+		}
+	})
+}
+
+// TestOpenAPISchemaNameDisambiguatesPackages is a regression test for
+// components.schemas collisions between same-named structs in different
+// packages.
+func TestOpenAPISchemaNameDisambiguatesPackages(t *testing.T) {
+	// Both types are named "Decoder", in packages encoding/json and encoding/xml.
+	nameA := openAPISchemaName(reflect.TypeOf(json.Decoder{}))
+	nameB := openAPISchemaName(reflect.TypeOf(xml.Decoder{}))
+	if nameA == nameB {
+		t.Fatalf("expected distinct schema names, got %q for both", nameA)
+	}
+	if nameA == "" || nameB == "" {
+		t.Fatal("schema name must not be empty")
+	}
+}
+
+// TestOpenAPIRouteRegistryIsolatedPerServer guards against recordOpenAPIRoute
+// regressing into a single process-wide registry: two servers must not see
+// each other's recorded routes, and a server that never records anything
+// must have none.
+func TestOpenAPIRouteRegistryIsolatedPerServer(t *testing.T) {
+	a, b := &Server{}, &Server{}
+	recordOpenAPIRoute(a, &openAPIRouteInfo{Method: "GET", Path: "/a", StructName: "A", MethodName: "Get"})
+
+	if got := len(a.openAPIRouteRegistry().routes); got != 1 {
+		t.Fatalf("server a routes = %d, want 1", got)
+	}
+	if got := len(b.openAPIRouteRegistry().routes); got != 0 {
+		t.Fatalf("server b routes = %d, want 0, got routes recorded for server a leaked into server b", got)
+	}
+}